@@ -34,12 +34,13 @@ type entry struct {
 	key     string
 	value   string
 	env     string
-	comment string //key的注释
+	comment string //key的注释，渲染在key所在行之前
+	inline  string //行尾注释，渲染在 value 之后，例如 `key="value" ; 说明`
 }
 
-type entries map[string]*entry
+type Entries map[string]*entry
 
-func (e entries) GetString(key string) string  {
+func (e Entries) GetString(key string) string  {
 	if v, ok := e[key]; ok {
 		if isValueEnv(v.value) {
 			_,vv := ParseValueEnv(v.value)
@@ -50,20 +51,20 @@ func (e entries) GetString(key string) string  {
 	return ""
 }
 
-func (e entries) DefaultString(key string, val string) string {
+func (e Entries) DefaultString(key string, val string) string {
 	if v:= e.GetString(key);v != "" {
 		return v
 	}
 	return val
 }
 
-func (e entries) DefaultStrings(key string, sep string, val []string) []string {
+func (e Entries) DefaultStrings(key string, sep string, val []string) []string {
 	if v := e.GetString(key);  v != "" {
 		return strings.Split(v, sep)
 	}
 	return val
 }
-func (e entries) DefaultInt(key string, val int) int {
+func (e Entries) DefaultInt(key string, val int) int {
 	if v:= e.GetString(key);v != "" {
 		if vv, err := strconv.Atoi(v); err == nil {
 			return vv
@@ -72,7 +73,7 @@ func (e entries) DefaultInt(key string, val int) int {
 	return val
 }
 
-func (e entries) DefaultInt64(key string, val int64) int64 {
+func (e Entries) DefaultInt64(key string, val int64) int64 {
 	if v:= e.GetString(key);v != "" {
 		if vv, err := strconv.ParseInt(v, 10, 64); err == nil {
 			return vv
@@ -81,7 +82,7 @@ func (e entries) DefaultInt64(key string, val int64) int64 {
 	return val
 }
 
-func (e entries) DefaultFloat(key string, val float64) float64 {
+func (e Entries) DefaultFloat(key string, val float64) float64 {
 	if v:= e.GetString(key);v != "" {
 		if vv, err := strconv.ParseFloat(v, 64); err == nil {
 			return vv
@@ -90,7 +91,7 @@ func (e entries) DefaultFloat(key string, val float64) float64 {
 	return val
 }
 
-func (e entries) DefaultBool(key string, val bool) bool {
+func (e Entries) DefaultBool(key string, val bool) bool {
 	if v:= e.GetString(key);v != "" {
 		if vv, err := ParseBool(v); err == nil {
 			return vv
@@ -101,9 +102,12 @@ func (e entries) DefaultBool(key string, val bool) bool {
 
 type IniContainer struct {
 	sync.RWMutex
-	values         map[string]entries
+	values         map[string]Entries
 	sectionComment map[string]string //节点的注释
 	endComment     string            //文件结束注释，一般在文件尾部
+	sourcePaths    []string          //LoadFromFile 及 include 指令拉取进来的所有源文件路径，供 Watch 使用
+	watch          *watchState       //Watch/OnChange 的运行时状态，未调用过 Watch 时为 nil
+	sectionParent  map[string]string //节点的继承关系，key 为子节点，value 为父节点
 }
 
 //从文件中加载配置信息
@@ -112,10 +116,12 @@ func LoadFromFile(path string) (ini *IniContainer, err error) {
 	return
 }
 
-func NewConfig() *IniContainer {
+// NewIniContainer 创建一个空的 *IniContainer，供需要直接操作 ini 格式的调用方使用.
+// 如果只关心统一的 Configer 接口，请使用 NewConfig/NewConfigData.
+func NewIniContainer() *IniContainer {
 	return &IniContainer{
 		RWMutex: sync.RWMutex{},
-		values:  make(map[string]entries),
+		values:  make(map[string]Entries),
 	}
 }
 
@@ -136,7 +142,7 @@ func (c *IniContainer) AddEntry(section, key, value string) *IniContainer {
 	}
 
 	if c.values == nil {
-		c.values = make(map[string]entries)
+		c.values = make(map[string]Entries)
 	}
 	if c.values[section] == nil {
 		c.values[section] = make(map[string]*entry)
@@ -200,7 +206,7 @@ func (c *IniContainer) AddSection(section string) *IniContainer {
 	}
 	if _, ok := c.values[section]; !ok {
 		if c.values == nil {
-			c.values = make(map[string]entries)
+			c.values = make(map[string]Entries)
 		}
 		c.values[section] = make(map[string]*entry)
 	}
@@ -224,7 +230,7 @@ func (c *IniContainer) SaveFile(path string) error {
 func (c *IniContainer) String() string {
 	body := ""
 
-	if c == nil || len(c.values) <= 0 {
+	if c == nil || (len(c.values) <= 0 && len(c.sectionComment) <= 0 && len(c.sectionParent) <= 0) {
 		return body
 	}
 	if section, ok := c.values[DefaultSection]; ok {
@@ -233,19 +239,25 @@ func (c *IniContainer) String() string {
 				if vv.comment != "" {
 					body += lineBreak + vv.comment + lineBreak
 				}
-				body += vv.key + "=\"" + vv.value + "\"" + lineBreak
+				body += formatEntryLine(vv) + lineBreak
 			}
 		}
 	}
-	for k, v := range c.values {
-		if k == "default" {
+	//即使 section 本身没有任何 key（纯继承声明），也要把它写出来
+	for _, k := range c.knownSections() {
+		if k == DefaultSection {
 			continue
 		}
+		v := c.values[k]
+		header := "[" + k + "]"
+		if parent, ok := c.sectionParent[k]; ok && parent != "" {
+			header = "[" + k + " : " + parent + "]"
+		}
 		//如果存在节点注释
 		if c, ok := c.sectionComment[k]; ok {
-			body += lineBreak + c + lineBreak + "[" + k + "]" + lineBreak
+			body += lineBreak + c + lineBreak + header + lineBreak
 		} else {
-			body += lineBreak + "[" + k + "]" + lineBreak
+			body += lineBreak + header + lineBreak
 		}
 
 		if v != nil && len(v) > 0 {
@@ -253,7 +265,7 @@ func (c *IniContainer) String() string {
 				if vv.comment != "" {
 					body += lineBreak + vv.comment + lineBreak
 				}
-				body += vv.key + "=\"" + vv.value + "\"" + lineBreak
+				body += formatEntryLine(vv) + lineBreak
 			}
 		}
 	}
@@ -262,6 +274,30 @@ func (c *IniContainer) String() string {
 	return body
 }
 
+//escapeEntryValue 将 value 中的 # 和 ; 转义为 \# 和 \;，
+//使得 parseData 能够把它们当作普通字符而不是注释标记解析回来.
+func escapeEntryValue(value string) string {
+	value = strings.ReplaceAll(value, "#", "\\#")
+	value = strings.ReplaceAll(value, ";", "\\;")
+	return value
+}
+
+//formatEntryLine 渲染单条 key=value，多行值使用三引号包裹，
+//行尾注释以 " ; comment" 的形式追加，保证与 parseData 互逆.
+func formatEntryLine(vv *entry) string {
+	line := ""
+	if strings.Contains(vv.value, lineBreak) {
+		//三引号包裹的多行值由 parseData 按字面量读回、不做反转义，所以这里也不转义
+		line = vv.key + `="""` + vv.value + `"""`
+	} else {
+		line = vv.key + "=\"" + escapeEntryValue(vv.value) + "\""
+	}
+	if vv.inline != "" {
+		line += " ;" + vv.inline
+	}
+	return line
+}
+
 func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 	if data == nil || len(data) <= 0 {
 		return &IniContainer{}, errors.New("data is empty")
@@ -269,7 +305,7 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 
 	cfg := &IniContainer{
 		RWMutex:        sync.RWMutex{},
-		values:         make(map[string]entries),
+		values:         make(map[string]Entries),
 		sectionComment: make(map[string]string),
 	}
 	cfg.Lock()
@@ -306,9 +342,18 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 			comment.Write(line)
 			continue
 		}
-		//解析节点
+		//解析节点，支持 "[section : parent]" 形式声明继承关系
 		if bytes.HasPrefix(line, sectionStart) && bytes.HasSuffix(line, sectionEnd) {
-			section = strings.ToLower(string(line[1 : len(line)-1]))
+			header := string(line[1 : len(line)-1])
+			if idx := strings.Index(header, ":"); idx >= 0 {
+				section = strings.ToLower(strings.TrimSpace(header[:idx]))
+				parent := strings.ToLower(strings.TrimSpace(header[idx+1:]))
+				if parent != "" {
+					cfg.setParent(section, parent)
+				}
+			} else {
+				section = strings.ToLower(strings.TrimSpace(header))
+			}
 			//当解析到节点时，将注释写给当前节点
 			cfg.sectionComment[section] = comment.String()
 			comment.Reset()
@@ -351,6 +396,9 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 										}
 									}
 								}
+								for _, p := range ini.sourcePaths {
+									cfg.sourcePaths = appendSourcePath(cfg.sourcePaths, p)
+								}
 							}
 						}
 						return nil
@@ -372,6 +420,9 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 								}
 							}
 						}
+						for _, p := range ini.sourcePaths {
+							cfg.sourcePaths = appendSourcePath(cfg.sourcePaths, p)
+						}
 					}
 					continue
 				}
@@ -381,15 +432,27 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 		if len(kv) != 2 {
 			return nil, errors.New("read the content error: \"" + string(line) + "\", should key = val")
 		}
-		val := bytes.TrimSpace(kv[1])
-		if bytes.HasPrefix(val, quote) {
-			val = bytes.Trim(val, `"`)
+		raw, literal := assembleRawValue(buf, bytes.TrimSpace(kv[1]))
+		var value, inline string
+		if literal {
+			//三引号和反斜杠续行拼出来的多行值自带显式终止符，不再按单行规则拆行尾注释，
+			//否则任意一行内出现 " #"/" ;" 都会把后面的内容整段丢掉.
+			value = raw
+		} else {
+			value, inline = splitValueAndComment(raw)
+		}
+		//`_parent = section` 是声明节点继承关系的特殊 key，不作为普通 entry 保存
+		if key == parentKey {
+			comment.Reset()
+			cfg.setParent(section, strings.ToLower(value))
+			continue
 		}
 		entryValue := &entry{
 			section: section,
 			key:     key,
-			value:   string(val),
+			value:   value,
 			comment: comment.String(),
+			inline:  inline,
 		}
 		comment.Reset()
 		if isValueEnv(entryValue.value) {
@@ -397,7 +460,7 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 			entryValue.env = k
 		}
 		if cfg.values == nil {
-			cfg.values = make(map[string]entries)
+			cfg.values = make(map[string]Entries)
 		}
 		if cfg.values[section] == nil {
 			cfg.values[section] = make(map[string]*entry)
@@ -410,6 +473,105 @@ func parseData(data []byte, section string, dir string) (*IniContainer, error) {
 	return cfg, nil
 }
 
+//assembleRawValue 读取一个 key=value 的值部分，处理三引号包裹的多行值
+//（"""..."""，可跨越多行直到终止的 """）和反斜杠续行（行尾 \ 表示与下一行拼接）。
+//这两种形式都已经有显式的终止符，返回的 literal 为 true 时调用方应把结果整体
+//当作字面量使用，不再交给 splitValueAndComment 按单行规则拆行尾注释——否则任意
+//一行内出现的 " #"/" ;" 都会被误判成注释，把后面的行整段丢掉.
+//普通单行值原样返回（literal 为 false），交由 splitValueAndComment 做引号/转义/
+//行尾注释处理.
+func assembleRawValue(buf *bufio.Reader, firstVal []byte) (value string, literal bool) {
+	tripleQuote := []byte(`"""`)
+	if bytes.HasPrefix(firstVal, tripleQuote) {
+		rest := firstVal[3:]
+		if idx := bytes.Index(rest, tripleQuote); idx >= 0 {
+			return string(rest[:idx]), true
+		}
+		var sb strings.Builder
+		sb.Write(rest)
+		for {
+			line, _, err := buf.ReadLine()
+			if err != nil {
+				break
+			}
+			if idx := bytes.Index(line, tripleQuote); idx >= 0 {
+				sb.WriteString(lineBreak)
+				sb.Write(line[:idx])
+				break
+			}
+			sb.WriteString(lineBreak)
+			sb.Write(line)
+		}
+		return sb.String(), true
+	}
+
+	var sb strings.Builder
+	cur := firstVal
+	for {
+		trimmed := bytes.TrimSpace(cur)
+		if bytes.HasSuffix(trimmed, []byte(`\`)) {
+			literal = true
+			sb.Write(bytes.TrimRight(trimmed[:len(trimmed)-1], " \t"))
+			sb.WriteString(lineBreak)
+			line, _, err := buf.ReadLine()
+			if err != nil {
+				break
+			}
+			cur = line
+			continue
+		}
+		sb.Write(trimmed)
+		break
+	}
+	return sb.String(), literal
+}
+
+//splitValueAndComment 从原始值中拆出真正的 value 和行尾注释.
+//整体被双引号包裹的值按字面量处理，引号内的 # 和 ; 不会被当作注释；
+//未加引号的值中，前面是空白的 # 或 ; 视为行尾注释的起始，
+//写成 \# 或 \; 可以转义为字面量，避免被误判为注释（例如 CSS 的 `color: #fff`）.
+func splitValueAndComment(raw string) (value, inline string) {
+	trimmed := strings.TrimSpace(raw)
+	if strings.HasPrefix(trimmed, `"`) {
+		if idx := strings.LastIndex(trimmed, `"`); idx > 0 {
+			inner := trimmed[1:idx]
+			rest := strings.TrimSpace(trimmed[idx+1:])
+			rest = strings.TrimPrefix(rest, ";")
+			rest = strings.TrimPrefix(rest, "#")
+			return unescapeEntryValue(inner), strings.TrimSpace(rest)
+		}
+	}
+
+	var valBuf, comBuf strings.Builder
+	runes := []rune(trimmed)
+	inComment := false
+	for i := 0; i < len(runes); i++ {
+		ch := runes[i]
+		if inComment {
+			comBuf.WriteRune(ch)
+			continue
+		}
+		if ch == '\\' && i+1 < len(runes) && (runes[i+1] == '#' || runes[i+1] == ';') {
+			valBuf.WriteRune(runes[i+1])
+			i++
+			continue
+		}
+		if (ch == '#' || ch == ';') && (i == 0 || runes[i-1] == ' ' || runes[i-1] == '\t') {
+			inComment = true
+			continue
+		}
+		valBuf.WriteRune(ch)
+	}
+	return strings.TrimSpace(valBuf.String()), strings.TrimSpace(comBuf.String())
+}
+
+//unescapeEntryValue 是 escapeEntryValue 的逆操作.
+func unescapeEntryValue(s string) string {
+	s = strings.ReplaceAll(s, `\#`, "#")
+	s = strings.ReplaceAll(s, `\;`, ";")
+	return s
+}
+
 //解析文件
 func parseFile(path string, section string) (*IniContainer, error) {
 
@@ -419,7 +581,23 @@ func parseFile(path string, section string) (*IniContainer, error) {
 		log.Println("read file error: ", err, path)
 		return nil, err
 	}
-	return parseData(b, section, filepath.Dir(path))
+	cfg, err := parseData(b, section, filepath.Dir(path))
+	if err != nil {
+		return nil, err
+	}
+	//入口文件放在最前面，include 拉取进来的文件排在其后，Watch 重新解析时以 sourcePaths[0] 为入口
+	cfg.sourcePaths = append([]string{path}, cfg.sourcePaths...)
+	return cfg, nil
+}
+
+//appendSourcePath 记录一个去重后的源文件路径，供 Watch 监听使用.
+func appendSourcePath(paths []string, path string) []string {
+	for _, p := range paths {
+		if p == path {
+			return paths
+		}
+	}
+	return append(paths, path)
 }
 
 //合并配置
@@ -435,7 +613,7 @@ func Merge(config1 *IniContainer, config2 *IniContainer) *IniContainer {
 	}
 	cfg := &IniContainer{
 		RWMutex: sync.RWMutex{},
-		values:  make(map[string]entries),
+		values:  make(map[string]Entries),
 	}
 	cfg.Lock()
 	defer cfg.Unlock()
@@ -453,6 +631,12 @@ func Merge(config1 *IniContainer, config2 *IniContainer) *IniContainer {
 			}
 		}
 	}
+	for k, v := range config1.sectionParent {
+		cfg.setParent(k, v)
+	}
+	for k, v := range config2.sectionParent {
+		cfg.setParent(k, v)
+	}
 	return cfg
 }
 
@@ -546,17 +730,32 @@ func (c *IniContainer) getData(key string) string {
 		section = DefaultSection
 		k = sectionKey[0]
 	}
-	if v, ok := c.values[section]; ok {
-		if vv, ok := v[k]; ok {
-			if isValueEnv(vv.value) {
-				_,vvv := ParseValueEnv(vv.value)
-				return vvv
-			}
-			return vv.value
+	if vv, ok := c.findEntry(section, k); ok {
+		if isValueEnv(vv.value) {
+			_, vvv := ParseValueEnv(vv.value)
+			return vvv
 		}
+		return vv.value
 	}
 	return ""
 }
+
+//findEntry 在 section 内查找 key，找不到时沿着 parent 链向上查找（带环检测）.
+//调用方需要自行持有锁.
+func (c *IniContainer) findEntry(section, key string) (*entry, bool) {
+	visited := make(map[string]bool)
+	for section != "" && !visited[section] {
+		visited[section] = true
+		if v, ok := c.values[section]; ok {
+			if vv, ok := v[key]; ok {
+				return vv, true
+			}
+		}
+		section = c.sectionParent[section]
+	}
+	return nil, false
+}
+
 func (c *IniContainer) Bool(key string) (bool, error) {
 	return ParseBool(c.getData(key))
 }
@@ -650,19 +849,29 @@ func (c *IniContainer) DefaultStrings(key string, defaultVal []string) []string
 }
 
 // GetSection returns map for the given section
+// GetSection 返回 section 下所有的 key/value，如果 section 声明了 parent，
+// 返回结果会先铺开父节点链路上的 key，再用当前节点的 key 覆盖同名项.
 func (c *IniContainer) GetSection(section string) (map[string]string, error) {
 	c.RLock()
 	defer c.RUnlock()
 
-	if v, ok := c.values[section]; ok {
-		values := make(map[string]string)
+	if !c.isKnownSection(section) {
+		return nil, errors.New("not exist section")
+	}
 
-		for k, vv := range v {
+	values := make(map[string]string)
+	var chain []string
+	visited := make(map[string]bool)
+	for s := section; s != "" && !visited[s]; s = c.sectionParent[s] {
+		visited[s] = true
+		chain = append(chain, s)
+	}
+	for i := len(chain) - 1; i >= 0; i-- {
+		for k, vv := range c.values[chain[i]] {
 			values[k] = vv.value
 		}
-		return values, nil
 	}
-	return nil, errors.New("not exist section")
+	return values, nil
 }
 
 // Set writes a new value for key.
@@ -702,9 +911,10 @@ func (c *IniContainer) Set(key, value string) error {
 }
 
 //遍历所有 Section .
-func (c *IniContainer) ForEach(fn func(section string, entries entries) bool) {
-	for s, entries := range c.values {
-		if !fn(s, entries) {
+func (c *IniContainer) ForEach(fn func(section string, entries Entries) bool) {
+	//即使 section 只是一个纯继承声明、没有任何 key，也要回调一次
+	for _, s := range c.knownSections() {
+		if !fn(s, c.values[s]) {
 			return
 		}
 	}
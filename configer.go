@@ -0,0 +1,78 @@
+package goini
+
+import (
+	"errors"
+	"strings"
+)
+
+// Configer 定义了与具体配置格式无关的统一访问接口.
+// IniContainer、JSONContainer、EnvContainer 均实现该接口，
+// 调用方可以在不同的配置后端之间切换而无需修改业务代码.
+type Configer interface {
+	Set(key, value string) error
+	GetString(key string) string
+	DefaultString(key, val string) string
+	Int(key string) (int, error)
+	Int64(key string) (int64, error)
+	Float(key string) (float64, error)
+	Bool(key string) (bool, error)
+	GetSection(section string) (map[string]string, error)
+	SaveTo(filename string) error
+	DIY(key string) (interface{}, error)
+	String() string
+}
+
+// Config 是配置解析器的抽象，每一种受支持的格式（ini、json、env ...）
+// 都需要实现 Parse 和 ParseData，分别从文件和内存数据中解析出 Configer.
+type Config interface {
+	Parse(path string) (Configer, error)
+	ParseData(data []byte) (Configer, error)
+}
+
+var adapters = make(map[string]Config)
+
+// Register 注册一个配置适配器，adapter 为适配器名称（如 "ini"、"json"、"env"）.
+// 如果 c 为 nil 或同一个 adapter 被重复注册，则会 panic，和 database/sql 的 Register 行为一致.
+func Register(adapter string, c Config) {
+	if c == nil {
+		panic("goini: Register adapter is nil")
+	}
+	if _, ok := adapters[adapter]; ok {
+		panic("goini: Register called twice for adapter " + adapter)
+	}
+	adapters[adapter] = c
+}
+
+// NewConfig 根据 adapter 名称和文件路径创建一个 Configer.
+func NewConfig(adapter, filename string) (Configer, error) {
+	c, ok := adapters[adapter]
+	if !ok {
+		return nil, errors.New("goini: unknown adapter \"" + adapter + "\" (forgot to import?)")
+	}
+	return c.Parse(filename)
+}
+
+// NewConfigData 根据 adapter 名称和原始数据创建一个 Configer.
+func NewConfigData(adapter string, data []byte) (Configer, error) {
+	c, ok := adapters[adapter]
+	if !ok {
+		return nil, errors.New("goini: unknown adapter \"" + adapter + "\" (forgot to import?)")
+	}
+	return c.ParseData(data)
+}
+
+func init() {
+	Register("ini", &iniConfig{})
+	Register("json", &jsonConfig{})
+	Register("env", &envConfig{})
+}
+
+// splitSectionKey 将形如 "section::key" 的键拆分为 (section, key)，
+// 不带 "::" 时落到 DefaultSection，和 IniContainer.getData 的约定保持一致.
+func splitSectionKey(key string) (section, k string) {
+	sectionKey := strings.Split(strings.ToLower(key), "::")
+	if len(sectionKey) >= 2 {
+		return sectionKey[0], sectionKey[1]
+	}
+	return DefaultSection, sectionKey[0]
+}
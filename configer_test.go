@@ -0,0 +1,43 @@
+package goini
+
+import "testing"
+
+func TestNewConfigData_IniAdapter(t *testing.T) {
+	cfg, err := NewConfigData("ini", []byte("httpport=8080\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.GetString("httpport") != "8080" {
+		t.Errorf("got %q, want 8080", cfg.GetString("httpport"))
+	}
+}
+
+func TestNewConfigData_JSONAdapter(t *testing.T) {
+	data := []byte(`{"httpport":"8080","redis":{"host":"127.0.0.1"}}`)
+	cfg, err := NewConfigData("json", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.GetString("httpport") != "8080" {
+		t.Errorf("got %q, want 8080", cfg.GetString("httpport"))
+	}
+	if cfg.GetString("redis::host") != "127.0.0.1" {
+		t.Errorf("got %q, want 127.0.0.1", cfg.GetString("redis::host"))
+	}
+}
+
+func TestNewConfigData_EnvAdapter(t *testing.T) {
+	cfg, err := NewConfigData("env", []byte("APP_PORT=8080\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.GetString("APP_PORT") != "8080" {
+		t.Errorf("got %q, want 8080", cfg.GetString("APP_PORT"))
+	}
+}
+
+func TestNewConfig_UnknownAdapter(t *testing.T) {
+	if _, err := NewConfigData("toml", []byte("")); err == nil {
+		t.Error("expected error for unregistered adapter")
+	}
+}
@@ -0,0 +1,78 @@
+package goini
+
+import (
+	"errors"
+	"time"
+)
+
+// Duration 返回 key 对应的 time.Duration 值.
+// 为了兼容历史配置，纯数字（没有单位）会被当作秒数处理，
+// 例如 "30" 等价于 "30s". ${ENV||default} 展开仍然在解析前生效.
+func (c *IniContainer) Duration(key string) (time.Duration, error) {
+	return parseDurationValue(c.getData(key))
+}
+
+// DefaultDuration 返回 key 对应的 time.Duration 值，解析失败时返回 defaultVal.
+func (c *IniContainer) DefaultDuration(key string, defaultVal time.Duration) time.Duration {
+	v, err := c.Duration(key)
+	if err != nil {
+		return defaultVal
+	}
+	return v
+}
+
+// Time 返回 key 对应的 time.Time 值，优先按 RFC3339 解析，
+// 失败后依次尝试传入的 layouts. ${ENV||default} 展开仍然在解析前生效.
+func (c *IniContainer) Time(key string, layouts ...string) (time.Time, error) {
+	return parseTimeValue(c.getData(key), append([]string{time.RFC3339}, layouts...)...)
+}
+
+// DefaultTime 返回 key 对应的 time.Time 值，解析失败时返回 defaultVal.
+func (c *IniContainer) DefaultTime(key string, defaultVal time.Time, layouts ...string) time.Time {
+	v, err := c.Time(key, layouts...)
+	if err != nil {
+		return defaultVal
+	}
+	return v
+}
+
+// Duration 返回 key 对应的 time.Duration 值，规则同 IniContainer.Duration.
+func (e Entries) Duration(key string) (time.Duration, error) {
+	return parseDurationValue(e.GetString(key))
+}
+
+// DefaultDuration 返回 key 对应的 time.Duration 值，解析失败时返回 defaultVal.
+func (e Entries) DefaultDuration(key string, defaultVal time.Duration) time.Duration {
+	v, err := e.Duration(key)
+	if err != nil {
+		return defaultVal
+	}
+	return v
+}
+
+// Time 返回 key 对应的 time.Time 值，规则同 IniContainer.Time.
+func (e Entries) Time(key string, layouts ...string) (time.Time, error) {
+	return parseTimeValue(e.GetString(key), append([]string{time.RFC3339}, layouts...)...)
+}
+
+// DefaultTime 返回 key 对应的 time.Time 值，解析失败时返回 defaultVal.
+func (e Entries) DefaultTime(key string, defaultVal time.Time, layouts ...string) time.Time {
+	v, err := e.Time(key, layouts...)
+	if err != nil {
+		return defaultVal
+	}
+	return v
+}
+
+// DIY 返回 key 对应的原始值（已完成 ${ENV||default} 展开）.
+// 如果 key 不存在则返回 error.
+func (e Entries) DIY(key string) (interface{}, error) {
+	if v, ok := e[key]; ok {
+		if isValueEnv(v.value) {
+			_, realValue := ParseValueEnv(v.value)
+			return realValue, nil
+		}
+		return v.value, nil
+	}
+	return nil, errors.New("key \"" + key + "\" not exist")
+}
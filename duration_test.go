@@ -0,0 +1,85 @@
+package goini
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIniContainer_Duration(t *testing.T) {
+	cfg, err := NewConfigData("ini", []byte("timeout=30s\nlegacy=45\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini := cfg.(*IniContainer)
+
+	d, err := ini.Duration("timeout")
+	if err != nil || d != 30*time.Second {
+		t.Errorf("Duration(timeout) = %v, %v; want 30s, nil", d, err)
+	}
+
+	d, err = ini.Duration("legacy")
+	if err != nil || d != 45*time.Second {
+		t.Errorf("Duration(legacy) = %v, %v; want 45s (bare int as seconds), nil", d, err)
+	}
+
+	if got := ini.DefaultDuration("missing", 5*time.Second); got != 5*time.Second {
+		t.Errorf("DefaultDuration(missing) = %v, want 5s fallback", got)
+	}
+}
+
+func TestIniContainer_Time(t *testing.T) {
+	cfg, err := NewConfigData("ini", []byte("created=2024-01-02T15:04:05Z\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini := cfg.(*IniContainer)
+
+	tm, err := ini.Time("created")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := time.Date(2024, 1, 2, 15, 4, 5, 0, time.UTC)
+	if !tm.Equal(want) {
+		t.Errorf("Time(created) = %v, want %v", tm, want)
+	}
+}
+
+func TestIniContainer_DIY(t *testing.T) {
+	cfg, err := NewConfigData("ini", []byte("name=goini\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini := cfg.(*IniContainer)
+
+	v, err := ini.DIY("name")
+	if err != nil || v != "goini" {
+		t.Errorf("DIY(name) = %v, %v; want goini, nil", v, err)
+	}
+	if _, err := ini.DIY("missing"); err == nil {
+		t.Error("DIY(missing) should return an error")
+	}
+}
+
+func TestEntries_DurationTimeDIY(t *testing.T) {
+	cfg, err := NewConfigData("ini", []byte("timeout=1m\ncreated=2024-01-02T15:04:05Z\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var result Entries
+	cfg.(*IniContainer).ForEach(func(section string, entries Entries) bool {
+		if section == DefaultSection {
+			result = entries
+			return false
+		}
+		return true
+	})
+	if result == nil {
+		t.Fatal("default section entries not found")
+	}
+	if d, err := result.Duration("timeout"); err != nil || d != time.Minute {
+		t.Errorf("Entries.Duration(timeout) = %v, %v; want 1m, nil", d, err)
+	}
+	if v, err := result.DIY("timeout"); err != nil || v != "1m" {
+		t.Errorf("Entries.DIY(timeout) = %v, %v; want 1m, nil", v, err)
+	}
+}
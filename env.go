@@ -0,0 +1,186 @@
+package goini
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// envConfig 是 env 格式的 Config 实现.
+// 它把文件内容当作一组 "KEY=VALUE" 行来解析。不带 "section::" 前缀的 key
+// 会原样写入真实的进程环境变量（os.Setenv，不检查 key 是否是合法的环境变量名），读取时优先读取
+// os.Getenv 的当前值，这样运行期对 os.Setenv 的修改也能被后续读取感知到；
+// 带 "section::key" 前缀的 key 只保留在 defaults 里，不去调用 os.Setenv —
+// 那样会把一个形如 "Redis::Host" 的怪名字设成真实的进程环境变量，是个意外的
+// 全局副作用，而且这种 key 读取时从来走不到 os.Getenv(key) 这条路.
+type envConfig struct{}
+
+func (e *envConfig) Parse(path string) (Configer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return e.ParseData(b)
+}
+
+func (e *envConfig) ParseData(data []byte) (Configer, error) {
+	cfg := &EnvContainer{defaults: make(map[string]map[string]string)}
+	buf := bufio.NewReader(bytes.NewBuffer(data))
+	for {
+		line, _, err := buf.ReadLine()
+		if err != nil {
+			break
+		}
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 || bytes.HasPrefix(line, defaultComment) || bytes.HasPrefix(line, alternativeComment) {
+			continue
+		}
+		kv := bytes.SplitN(line, equal, 2)
+		if len(kv) != 2 {
+			continue
+		}
+		key := string(bytes.TrimSpace(kv[0]))
+		value := string(bytes.TrimSpace(kv[1]))
+		value = strings.Trim(value, `"`)
+		section, k := splitSectionKey(key)
+		if cfg.defaults[section] == nil {
+			cfg.defaults[section] = make(map[string]string)
+		}
+		cfg.defaults[section][k] = value
+
+		realValue := value
+		if isValueEnv(value) {
+			_, realValue = ParseValueEnv(value)
+		}
+		if !strings.Contains(key, "::") {
+			_ = os.Setenv(key, realValue)
+		}
+	}
+	return cfg, nil
+}
+
+// EnvContainer 是 env 格式的 Configer 实现，本质上是一个由
+// os.Getenv/os.Setenv 支撑的 key/value 视图.
+type EnvContainer struct {
+	sync.RWMutex
+	defaults map[string]map[string]string
+}
+
+func (c *EnvContainer) Set(key, value string) error {
+	if len(key) == 0 {
+		return errors.New("key is empty")
+	}
+	c.Lock()
+	defer c.Unlock()
+	section, k := splitSectionKey(key)
+	if c.defaults[section] == nil {
+		c.defaults[section] = make(map[string]string)
+	}
+	c.defaults[section][k] = value
+	if strings.Contains(key, "::") {
+		return nil
+	}
+	return os.Setenv(key, value)
+}
+
+func (c *EnvContainer) getData(key string) string {
+	//只有不带 "section::" 前缀的 key 才可能真的是通过 os.Setenv 写入的环境变量，
+	//带前缀的 key 从来不会被 Setenv，直接走 defaults 即可.
+	if !strings.Contains(key, "::") {
+		if v := os.Getenv(key); v != "" {
+			return v
+		}
+	}
+	c.RLock()
+	defer c.RUnlock()
+	section, k := splitSectionKey(key)
+	if v, ok := c.defaults[section]; ok {
+		if vv, ok := v[k]; ok {
+			if isValueEnv(vv) {
+				_, realValue := ParseValueEnv(vv)
+				return realValue
+			}
+			return vv
+		}
+	}
+	return ""
+}
+
+func (c *EnvContainer) GetString(key string) string {
+	return c.getData(key)
+}
+
+func (c *EnvContainer) DefaultString(key, val string) string {
+	if v := c.GetString(key); v != "" {
+		return v
+	}
+	return val
+}
+
+func (c *EnvContainer) Int(key string) (int, error) {
+	return strconv.Atoi(c.getData(key))
+}
+
+func (c *EnvContainer) Int64(key string) (int64, error) {
+	return strconv.ParseInt(c.getData(key), 10, 64)
+}
+
+func (c *EnvContainer) Float(key string) (float64, error) {
+	return strconv.ParseFloat(c.getData(key), 64)
+}
+
+func (c *EnvContainer) Bool(key string) (bool, error) {
+	return ParseBool(c.getData(key))
+}
+
+func (c *EnvContainer) GetSection(section string) (map[string]string, error) {
+	c.RLock()
+	defer c.RUnlock()
+	if v, ok := c.defaults[section]; ok {
+		values := make(map[string]string, len(v))
+		for k, vv := range v {
+			values[k] = vv
+		}
+		return values, nil
+	}
+	return nil, errors.New("not exist section")
+}
+
+func (c *EnvContainer) DIY(key string) (interface{}, error) {
+	v := c.getData(key)
+	if v == "" {
+		return nil, errors.New("key \"" + key + "\" not exist")
+	}
+	return v, nil
+}
+
+func (c *EnvContainer) String() string {
+	c.RLock()
+	defer c.RUnlock()
+	body := ""
+	for section, kv := range c.defaults {
+		for k, v := range kv {
+			key := k
+			if section != DefaultSection {
+				key = section + "::" + k
+			}
+			body += key + "=" + v + lineBreak
+		}
+	}
+	return body
+}
+
+func (c *EnvContainer) SaveTo(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.WriteString(c.String())
+	return err
+}
@@ -0,0 +1,38 @@
+package goini
+
+import (
+	"os"
+	"testing"
+)
+
+func TestEnvAdapter_SectionedKeyDoesNotLeakIntoProcessEnv(t *testing.T) {
+	os.Unsetenv("Redis::Host")
+	defer os.Unsetenv("Redis::Host")
+
+	cfg, err := NewConfigData("env", []byte("Redis::Host=127.0.0.1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.GetString("Redis::Host"); got != "127.0.0.1" {
+		t.Errorf("GetString(Redis::Host) = %q, want 127.0.0.1", got)
+	}
+	if v, ok := os.LookupEnv("Redis::Host"); ok {
+		t.Errorf("os.Setenv should not be called for a sectioned key, but found env var %q=%q", "Redis::Host", v)
+	}
+}
+
+func TestEnvAdapter_BareKeySetsProcessEnv(t *testing.T) {
+	os.Unsetenv("TEST_ENV_ADAPTER_PORT")
+	defer os.Unsetenv("TEST_ENV_ADAPTER_PORT")
+
+	cfg, err := NewConfigData("env", []byte("TEST_ENV_ADAPTER_PORT=9090\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.GetString("TEST_ENV_ADAPTER_PORT"); got != "9090" {
+		t.Errorf("GetString(TEST_ENV_ADAPTER_PORT) = %q, want 9090", got)
+	}
+	if got := os.Getenv("TEST_ENV_ADAPTER_PORT"); got != "9090" {
+		t.Errorf("os.Getenv(TEST_ENV_ADAPTER_PORT) = %q, want 9090", got)
+	}
+}
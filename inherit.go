@@ -0,0 +1,75 @@
+package goini
+
+import "strings"
+
+// parentKey 是在 section 内声明继承关系的特殊 key，
+// 等价于在节点头写 "[section : parent]"，两种写法可以混用，后解析到的生效.
+const parentKey = "_parent"
+
+// setParent 记录 section 的父节点，由调用方保证 cfg 已持有锁.
+func (c *IniContainer) setParent(section, parent string) {
+	section = strings.TrimSpace(section)
+	parent = strings.TrimSpace(parent)
+	if section == "" || parent == "" || section == parent {
+		return
+	}
+	if c.sectionParent == nil {
+		c.sectionParent = make(map[string]string)
+	}
+	c.sectionParent[section] = parent
+}
+
+// Parent 返回 section 声明的父节点名称，如果没有继承关系则返回 ("", false).
+func (c *IniContainer) Parent(section string) (string, bool) {
+	c.RLock()
+	defer c.RUnlock()
+	parent, ok := c.sectionParent[section]
+	return parent, ok
+}
+
+// SetParent 显式声明 section 继承自 parent，效果等同于在文件中写
+// "[section : parent]" 或 section 内的 "_parent = parent".
+func (c *IniContainer) SetParent(section, parent string) {
+	c.Lock()
+	defer c.Unlock()
+	c.setParent(section, parent)
+}
+
+// isKnownSection 判断 section 是否被声明过，即便它只是一个纯继承声明、
+// 自身没有任何 key（例如 "[staging : default]" 后面一个 key 都没写）.
+// 调用方需要自行持有锁.
+func (c *IniContainer) isKnownSection(section string) bool {
+	if _, ok := c.values[section]; ok {
+		return true
+	}
+	if _, ok := c.sectionComment[section]; ok {
+		return true
+	}
+	if _, ok := c.sectionParent[section]; ok {
+		return true
+	}
+	return false
+}
+
+// knownSections 返回所有声明过的 section 名称（去重），
+// 包含只有继承声明、没有任何 key 的 section.
+func (c *IniContainer) knownSections() []string {
+	seen := make(map[string]bool)
+	var out []string
+	add := func(s string) {
+		if !seen[s] {
+			seen[s] = true
+			out = append(out, s)
+		}
+	}
+	for s := range c.values {
+		add(s)
+	}
+	for s := range c.sectionComment {
+		add(s)
+	}
+	for s := range c.sectionParent {
+		add(s)
+	}
+	return out
+}
@@ -0,0 +1,83 @@
+package goini
+
+import "testing"
+
+func TestSectionInheritance_ParentOnlySection(t *testing.T) {
+	data := []byte("[default]\nhost = example.com\nport = 80\n\n[staging : default]\n")
+	cfg, err := NewConfigData("ini", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini := cfg.(*IniContainer)
+
+	if got := ini.GetString("staging::host"); got != "example.com" {
+		t.Errorf("staging::host = %q, want inherited %q", got, "example.com")
+	}
+
+	sec, err := ini.GetSection("staging")
+	if err != nil {
+		t.Fatalf("GetSection(staging) returned error for a valid parent-only section: %v", err)
+	}
+	if sec["host"] != "example.com" || sec["port"] != "80" {
+		t.Errorf("GetSection(staging) = %v, want inherited host/port from default", sec)
+	}
+}
+
+func TestSectionInheritance_ParentKeyDirective(t *testing.T) {
+	data := []byte("[production]\nhost = prod.example.com\n\n[staging]\n_parent = production\ndebug = true\n")
+	cfg, err := NewConfigData("ini", data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini := cfg.(*IniContainer)
+
+	if got := ini.GetString("staging::host"); got != "prod.example.com" {
+		t.Errorf("staging::host = %q, want inherited %q", got, "prod.example.com")
+	}
+	if parent, ok := ini.Parent("staging"); !ok || parent != "production" {
+		t.Errorf("Parent(staging) = %q, %v; want production, true", parent, ok)
+	}
+}
+
+func TestSectionInheritance_CycleDetection(t *testing.T) {
+	data := []byte("[a]\n_parent = b\n\n[b]\n_parent = a\nkey = v\n")
+	ini, err := parseData(data, DefaultSection, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// neither section declares "missing" and the parent chain cycles back on
+	// itself, so lookup must terminate instead of looping forever.
+	if got := ini.GetString("a::missing"); got != "" {
+		t.Errorf("a::missing = %q, want empty on a cyclic parent chain", got)
+	}
+}
+
+func TestSectionInheritance_RoundTrip(t *testing.T) {
+	data := []byte("[default]\nhost = example.com\n\n[staging : default]\n")
+	ini, err := parseData(data, DefaultSection, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	serialized := ini.String()
+	reparsed, err := parseData([]byte(serialized), DefaultSection, "")
+	if err != nil {
+		t.Fatalf("could not reparse own output: %v\n%s", err, serialized)
+	}
+
+	if parent, ok := reparsed.Parent("staging"); !ok || parent != "default" {
+		t.Errorf("after round-trip, Parent(staging) = %q, %v; want default, true (serialized:\n%s)", parent, ok, serialized)
+	}
+
+	found := false
+	reparsed.ForEach(func(section string, entries Entries) bool {
+		if section == "staging" {
+			found = true
+			return false
+		}
+		return true
+	})
+	if !found {
+		t.Errorf("ForEach did not visit the parent-only \"staging\" section after round-trip")
+	}
+}
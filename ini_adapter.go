@@ -0,0 +1,36 @@
+package goini
+
+import "errors"
+
+// iniConfig 是 ini 格式的 Config 实现，内部直接复用 parseFile/parseData.
+type iniConfig struct{}
+
+func (ini *iniConfig) Parse(path string) (Configer, error) {
+	return LoadFromFile(path)
+}
+
+func (ini *iniConfig) ParseData(data []byte) (Configer, error) {
+	return parseData(data, DefaultSection, "")
+}
+
+// SaveTo 是 SaveFile 的 Configer 接口实现，行为完全一致.
+func (c *IniContainer) SaveTo(filename string) error {
+	return c.SaveFile(filename)
+}
+
+// DIY 返回 key 对应的原始值（已完成 ${ENV||default} 展开），
+// 如果 key 不存在则返回 error.
+func (c *IniContainer) DIY(key string) (interface{}, error) {
+	c.RLock()
+	defer c.RUnlock()
+
+	section, k := splitSectionKey(key)
+	if vv, ok := c.findEntry(section, k); ok {
+		if isValueEnv(vv.value) {
+			_, realValue := ParseValueEnv(vv.value)
+			return realValue, nil
+		}
+		return vv.value, nil
+	}
+	return nil, errors.New("key \"" + key + "\" not exist")
+}
@@ -0,0 +1,185 @@
+package goini
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// jsonConfig 是 json 格式的 Config 实现.
+type jsonConfig struct{}
+
+func (j *jsonConfig) Parse(path string) (Configer, error) {
+	b, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return j.ParseData(b)
+}
+
+func (j *jsonConfig) ParseData(data []byte) (Configer, error) {
+	raw := make(map[string]interface{})
+	if len(data) > 0 {
+		if err := json.Unmarshal(data, &raw); err != nil {
+			return nil, err
+		}
+	}
+	cfg := &JSONContainer{values: make(map[string]map[string]string)}
+	for k, v := range raw {
+		k = strings.ToLower(k)
+		if section, ok := v.(map[string]interface{}); ok {
+			if cfg.values[k] == nil {
+				cfg.values[k] = make(map[string]string)
+			}
+			for sk, sv := range section {
+				cfg.values[k][strings.ToLower(sk)] = jsonScalarToString(sv)
+			}
+			continue
+		}
+		if cfg.values[DefaultSection] == nil {
+			cfg.values[DefaultSection] = make(map[string]string)
+		}
+		cfg.values[DefaultSection][k] = jsonScalarToString(v)
+	}
+	return cfg, nil
+}
+
+func jsonScalarToString(v interface{}) string {
+	switch vv := v.(type) {
+	case string:
+		return vv
+	case nil:
+		return ""
+	default:
+		return fmt.Sprintf("%v", vv)
+	}
+}
+
+// JSONContainer 是 json 格式的 Configer 实现.
+// 顶层的 JSON 对象字段映射为节点（section），节点下的字段映射为 key/value;
+// 不属于任何对象的顶层标量字段归入 DefaultSection.
+type JSONContainer struct {
+	sync.RWMutex
+	values map[string]map[string]string
+}
+
+func (c *JSONContainer) Set(key, value string) error {
+	c.Lock()
+	defer c.Unlock()
+	if len(key) == 0 {
+		return errors.New("key is empty")
+	}
+	section, k := splitSectionKey(key)
+	if c.values[section] == nil {
+		c.values[section] = make(map[string]string)
+	}
+	c.values[section][k] = value
+	return nil
+}
+
+func (c *JSONContainer) getData(key string) string {
+	c.RLock()
+	defer c.RUnlock()
+	section, k := splitSectionKey(key)
+	if v, ok := c.values[section]; ok {
+		if vv, ok := v[k]; ok {
+			if isValueEnv(vv) {
+				_, realValue := ParseValueEnv(vv)
+				return realValue
+			}
+			return vv
+		}
+	}
+	return ""
+}
+
+func (c *JSONContainer) GetString(key string) string {
+	return c.getData(key)
+}
+
+func (c *JSONContainer) DefaultString(key, val string) string {
+	if v := c.GetString(key); v != "" {
+		return v
+	}
+	return val
+}
+
+func (c *JSONContainer) Int(key string) (int, error) {
+	return strconv.Atoi(c.getData(key))
+}
+
+func (c *JSONContainer) Int64(key string) (int64, error) {
+	return strconv.ParseInt(c.getData(key), 10, 64)
+}
+
+func (c *JSONContainer) Float(key string) (float64, error) {
+	return strconv.ParseFloat(c.getData(key), 64)
+}
+
+func (c *JSONContainer) Bool(key string) (bool, error) {
+	return ParseBool(c.getData(key))
+}
+
+func (c *JSONContainer) GetSection(section string) (map[string]string, error) {
+	c.RLock()
+	defer c.RUnlock()
+	if v, ok := c.values[section]; ok {
+		values := make(map[string]string, len(v))
+		for k, vv := range v {
+			values[k] = vv
+		}
+		return values, nil
+	}
+	return nil, errors.New("not exist section")
+}
+
+func (c *JSONContainer) DIY(key string) (interface{}, error) {
+	c.RLock()
+	defer c.RUnlock()
+	section, k := splitSectionKey(key)
+	if v, ok := c.values[section]; ok {
+		if vv, ok := v[k]; ok {
+			if isValueEnv(vv) {
+				_, realValue := ParseValueEnv(vv)
+				return realValue, nil
+			}
+			return vv, nil
+		}
+	}
+	return nil, errors.New("key \"" + key + "\" not exist")
+}
+
+func (c *JSONContainer) String() string {
+	c.RLock()
+	defer c.RUnlock()
+	out := make(map[string]interface{}, len(c.values))
+	for section, kv := range c.values {
+		if section == DefaultSection {
+			for k, v := range kv {
+				out[k] = v
+			}
+			continue
+		}
+		out[section] = kv
+	}
+	b, err := json.MarshalIndent(out, "", "  ")
+	if err != nil {
+		return ""
+	}
+	return string(b)
+}
+
+func (c *JSONContainer) SaveTo(filename string) error {
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	_, err = f.WriteString(c.String())
+	return err
+}
@@ -0,0 +1,266 @@
+package goini
+
+import (
+	"errors"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const defaultDelim = ";"
+
+// iniFieldTag 描述了一个结构体字段如何映射到 ini 的 key.
+type iniFieldTag struct {
+	name  string // 为空表示沿用字段名的小写形式
+	skip  bool   // tag 为 "-" 时跳过该字段
+	delim string // []string 的分隔符，默认 ";"
+	env   string // env=VAR 或 env=VAR||default 中 "=" 右侧的表达式，为空表示没有声明 env 选项
+}
+
+func parseIniFieldTag(field reflect.StructField) iniFieldTag {
+	tag := iniFieldTag{delim: defaultDelim}
+	raw, ok := field.Tag.Lookup("ini")
+	if !ok {
+		tag.name = strings.ToLower(field.Name)
+		return tag
+	}
+	parts := strings.Split(raw, ",")
+	if parts[0] == "-" {
+		tag.skip = true
+		return tag
+	}
+	if parts[0] == "" {
+		tag.name = strings.ToLower(field.Name)
+	} else {
+		tag.name = parts[0]
+	}
+	for _, opt := range parts[1:] {
+		switch {
+		case strings.HasPrefix(opt, "delim="):
+			tag.delim = strings.TrimPrefix(opt, "delim=")
+		case strings.HasPrefix(opt, "env="):
+			tag.env = strings.TrimPrefix(opt, "env=")
+		}
+	}
+	return tag
+}
+
+// MapTo 将配置内容映射到结构体 v 上. v 必须是非 nil 的结构体指针.
+//
+// 字段通过 `ini:"key"` tag 或字段名的小写形式对应默认节点下的 key；
+// 嵌套结构体字段映射到以外层字段命名的节点（ini tag 指定的名字或字段名小写）；
+// 顶层标量字段使用默认节点(DefaultSection).
+// 如果 tag 还带有 `env=VAR` 或 `env=VAR||default` 选项，
+// 在配置项为空时会按 ${VAR||default} 的规则展开并回退使用该值
+// （规则与 ParseValueEnv 完全一致）.
+func (c *IniContainer) MapTo(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() != reflect.Ptr || rv.IsNil() || rv.Elem().Kind() != reflect.Struct {
+		return errors.New("goini: MapTo requires a non-nil struct pointer")
+	}
+	return c.mapToStruct(rv.Elem(), DefaultSection)
+}
+
+func (c *IniContainer) mapToStruct(rv reflect.Value, section string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue //跳过未导出字段
+		}
+		tag := parseIniFieldTag(field)
+		if tag.skip {
+			continue
+		}
+		fv := rv.Field(i)
+
+		if fv.Kind() == reflect.Struct && fv.Type() != reflect.TypeOf(time.Time{}) {
+			if err := c.mapToStruct(fv, tag.name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		raw := c.GetString(section + "::" + tag.name)
+		if raw == "" && tag.env != "" {
+			_, raw = ParseValueEnv("${" + tag.env + "}")
+		}
+		if raw == "" {
+			continue
+		}
+		if err := setFieldValue(fv, raw, tag.delim); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// parseDurationValue 解析 time.Duration，兼容历史上把纯数字当成秒的写法.
+func parseDurationValue(raw string) (time.Duration, error) {
+	if d, err := time.ParseDuration(raw); err == nil {
+		return d, nil
+	}
+	if secs, err := strconv.ParseInt(raw, 10, 64); err == nil {
+		return time.Duration(secs) * time.Second, nil
+	}
+	return 0, errors.New("goini: invalid duration \"" + raw + "\"")
+}
+
+// parseTimeValue 按顺序依次尝试 layouts，返回第一个解析成功的结果；
+// 它自身不附加 RFC3339，RFC3339 由调用方放在 layouts 的首位.
+func parseTimeValue(raw string, layouts ...string) (time.Time, error) {
+	for _, layout := range layouts {
+		if t, err := time.Parse(layout, raw); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("goini: invalid time \"" + raw + "\"")
+}
+
+func setFieldValue(fv reflect.Value, raw string, delim string) error {
+	switch fv.Interface().(type) {
+	case time.Duration:
+		d, err := parseDurationValue(raw)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(d))
+		return nil
+	case time.Time:
+		t, err := parseTimeValue(raw, time.RFC3339)
+		if err != nil {
+			return err
+		}
+		fv.Set(reflect.ValueOf(t))
+		return nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		fv.SetString(raw)
+	case reflect.Bool:
+		b, err := ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		fv.SetBool(b)
+	case reflect.Int, reflect.Int64:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetInt(n)
+	case reflect.Uint:
+		n, err := strconv.ParseUint(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetUint(n)
+	case reflect.Float64:
+		f, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		fv.SetFloat(f)
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() != reflect.String {
+			return nil
+		}
+		fv.Set(reflect.ValueOf(strings.Split(raw, delim)))
+	}
+	return nil
+}
+
+// ReflectFrom 是 MapTo 的逆操作，把结构体 v 的字段写回 IniContainer.
+// 已存在的 sectionComment/entry 注释会被保留，只更新 value.
+func (c *IniContainer) ReflectFrom(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Ptr {
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return errors.New("goini: ReflectFrom requires a struct or struct pointer")
+	}
+	return c.reflectFromStruct(rv, DefaultSection)
+}
+
+func (c *IniContainer) reflectFromStruct(rv reflect.Value, section string) error {
+	rt := rv.Type()
+	for i := 0; i < rt.NumField(); i++ {
+		field := rt.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		tag := parseIniFieldTag(field)
+		if tag.skip {
+			continue
+		}
+		fv := rv.Field(i)
+
+		if fv.Kind() == reflect.Struct && fv.Type() != reflect.TypeOf(time.Time{}) {
+			c.AddSection(tag.name)
+			if err := c.reflectFromStruct(fv, tag.name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		value, err := fieldValueString(fv, tag.delim)
+		if err != nil {
+			return err
+		}
+		c.setPreservingComment(section, tag.name, value)
+	}
+	return nil
+}
+
+// setPreservingComment 更新 value 但保留已存在 entry 的注释，
+// 这样 ReflectFrom 之后再 SaveFile 不会丢失原有的注释.
+func (c *IniContainer) setPreservingComment(section, key, value string) {
+	c.Lock()
+	defer c.Unlock()
+	if c.values == nil {
+		c.values = make(map[string]Entries)
+	}
+	if c.values[section] == nil {
+		c.values[section] = make(map[string]*entry)
+	}
+	if old, ok := c.values[section][key]; ok {
+		old.value = value
+		return
+	}
+	c.values[section][key] = &entry{section: section, key: key, value: value}
+}
+
+func fieldValueString(fv reflect.Value, delim string) (string, error) {
+	switch vv := fv.Interface().(type) {
+	case time.Duration:
+		return vv.String(), nil
+	case time.Time:
+		return vv.Format(time.RFC3339), nil
+	}
+
+	switch fv.Kind() {
+	case reflect.String:
+		return fv.String(), nil
+	case reflect.Bool:
+		return strconv.FormatBool(fv.Bool()), nil
+	case reflect.Int, reflect.Int64:
+		return strconv.FormatInt(fv.Int(), 10), nil
+	case reflect.Uint:
+		return strconv.FormatUint(fv.Uint(), 10), nil
+	case reflect.Float64:
+		return strconv.FormatFloat(fv.Float(), 'f', -1, 64), nil
+	case reflect.Slice:
+		if fv.Type().Elem().Kind() != reflect.String {
+			return "", nil
+		}
+		items := make([]string, fv.Len())
+		for i := range items {
+			items[i] = fv.Index(i).String()
+		}
+		return strings.Join(items, delim), nil
+	}
+	return "", nil
+}
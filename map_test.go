@@ -0,0 +1,83 @@
+package goini
+
+import (
+	"os"
+	"testing"
+	"time"
+)
+
+type testRedisConfig struct {
+	Host string `ini:"host"`
+}
+
+type testMapConfig struct {
+	Port    string        `ini:"port,env=TEST_MAPTO_PORT||9999"`
+	Timeout time.Duration `ini:"timeout"`
+	Tags    []string      `ini:"tags,delim=|"`
+	Redis   testRedisConfig
+}
+
+func TestMapTo(t *testing.T) {
+	os.Unsetenv("TEST_MAPTO_PORT")
+	cfg, err := NewConfigData("ini", []byte("timeout=30s\ntags=a|b|c\n\n[redis]\nhost=127.0.0.1\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ini := cfg.(*IniContainer)
+
+	var dst testMapConfig
+	if err := ini.MapTo(&dst); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Port != "9999" {
+		t.Errorf("Port = %q, want fallback 9999", dst.Port)
+	}
+	if dst.Timeout != 30*time.Second {
+		t.Errorf("Timeout = %v, want 30s", dst.Timeout)
+	}
+	if len(dst.Tags) != 3 || dst.Tags[0] != "a" || dst.Tags[2] != "c" {
+		t.Errorf("Tags = %v, want [a b c]", dst.Tags)
+	}
+	if dst.Redis.Host != "127.0.0.1" {
+		t.Errorf("Redis.Host = %q, want 127.0.0.1", dst.Redis.Host)
+	}
+}
+
+func TestMapTo_EnvOption(t *testing.T) {
+	os.Setenv("TEST_MAPTO_PORT", "1234")
+	defer os.Unsetenv("TEST_MAPTO_PORT")
+
+	cfg, err := NewConfigData("ini", []byte("# empty\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var dst testMapConfig
+	if err := cfg.(*IniContainer).MapTo(&dst); err != nil {
+		t.Fatal(err)
+	}
+	if dst.Port != "1234" {
+		t.Errorf("Port = %q, want 1234 from env", dst.Port)
+	}
+}
+
+func TestReflectFrom(t *testing.T) {
+	src := testMapConfig{Port: "8080", Timeout: 5 * time.Second, Tags: []string{"x", "y"}}
+	src.Redis.Host = "10.0.0.1"
+
+	ini := NewIniContainer()
+	if err := ini.ReflectFrom(&src); err != nil {
+		t.Fatal(err)
+	}
+	if ini.GetString("port") != "8080" {
+		t.Errorf("port = %q, want 8080", ini.GetString("port"))
+	}
+	if ini.GetString("timeout") != "5s" {
+		t.Errorf("timeout = %q, want 5s", ini.GetString("timeout"))
+	}
+	if ini.GetString("tags") != "x|y" {
+		t.Errorf("tags = %q, want x|y", ini.GetString("tags"))
+	}
+	if ini.GetString("redis::host") != "10.0.0.1" {
+		t.Errorf("redis::host = %q, want 10.0.0.1", ini.GetString("redis::host"))
+	}
+}
@@ -0,0 +1,73 @@
+package goini
+
+import "testing"
+
+func TestParseData_EscapedCommentMarkers(t *testing.T) {
+	data := []byte(`style = "color: #fff;" ; a quoted css value
+escaped = foo \# bar \; baz ; trailing note
+`)
+	cfg, err := parseData(data, DefaultSection, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.GetString("style"); got != "color: #fff;" {
+		t.Errorf("style = %q, want %q", got, "color: #fff;")
+	}
+	if got := cfg.GetString("escaped"); got != "foo # bar ; baz" {
+		t.Errorf("escaped = %q, want %q", got, "foo # bar ; baz")
+	}
+}
+
+func TestParseData_MultilineAndContinuation(t *testing.T) {
+	data := []byte("multi = \"\"\"line one\nline two\"\"\"\ncont = first \\\nsecond\n")
+	cfg, err := parseData(data, DefaultSection, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := cfg.GetString("multi"); got != "line one\nline two" {
+		t.Errorf("multi = %q, want %q", got, "line one\nline two")
+	}
+	if got := cfg.GetString("cont"); got != "first\nsecond" {
+		t.Errorf("cont = %q, want %q", got, "first\nsecond")
+	}
+}
+
+func TestParseData_MultilineValueContainingCommentMarker(t *testing.T) {
+	data := []byte("multi = \"\"\"line one\nsecond line has a # symbol in it\nline three\"\"\"\n" +
+		"cont = first \\\nsecond line has a ; marker in it\n")
+	cfg, err := parseData(data, DefaultSection, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := "line one\nsecond line has a # symbol in it\nline three"
+	if got := cfg.GetString("multi"); got != want {
+		t.Errorf("multi = %q, want %q", got, want)
+	}
+	want = "first\nsecond line has a ; marker in it"
+	if got := cfg.GetString("cont"); got != want {
+		t.Errorf("cont = %q, want %q", got, want)
+	}
+}
+
+func TestString_RoundTripIsIdempotent(t *testing.T) {
+	data := []byte(`style = "color: #fff;" ; note
+multi = """line one
+line two"""
+`)
+	cfg, err := parseData(data, DefaultSection, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialized := cfg.String()
+
+	reparsed, err := parseData([]byte(serialized), DefaultSection, "")
+	if err != nil {
+		t.Fatalf("could not reparse own output: %v\n%s", err, serialized)
+	}
+	if got := reparsed.GetString("style"); got != "color: #fff;" {
+		t.Errorf("reparsed style = %q, want %q", got, "color: #fff;")
+	}
+	if got := reparsed.GetString("multi"); got != "line one\nline two" {
+		t.Errorf("reparsed multi = %q, want %q", got, "line one\nline two")
+	}
+}
@@ -0,0 +1,197 @@
+package goini
+
+import (
+	"context"
+	"os"
+	"time"
+)
+
+//debounce 是文件变化轮询的最小间隔，避免编辑器连续写入时重复触发重新解析.
+const debounce = 200 * time.Millisecond
+
+// EventKind 标识一次配置变化的类型.
+type EventKind int
+
+const (
+	// EventChanged 表示某个 section/key 的值发生了变化（新增、删除或修改）.
+	EventChanged EventKind = iota
+	// EventReloaded 表示整个文件被成功重新解析了一次.
+	EventReloaded
+)
+
+// Event 描述一次热加载产生的变化.
+// Kind 为 EventReloaded 时，Section/Key/OldValue/NewValue 均为空，
+// 只表示“本轮重新解析完成”，具体差异已经通过之前的 EventChanged 发出.
+type Event struct {
+	Section  string
+	Key      string
+	OldValue string
+	NewValue string
+	Kind     EventKind
+}
+
+// watchState 保存 Watch/OnChange 的运行时状态.
+type watchState struct {
+	cancel context.CancelFunc
+}
+
+// Watch 监听 LoadFromFile（以及通过 include 引入的所有文件）的变化，
+// 每隔 debounce 轮询一次文件状态，发现变化后重新解析并在返回的 channel 上
+// 发出差异事件，最后附带一个 EventReloaded 事件.
+// ctx 被取消或调用 StopWatch 后，channel 会被关闭.
+//
+// 本来应该用 fsnotify 做事件驱动的监听，但这个包目前还没有 go.mod、也没有引入
+// 任何第三方依赖，没法干净地拉取 fsnotify；这里先用轮询顶上，引入 go.mod 之后
+// 应该换成 fsnotify。为了让轮询在文件系统 mtime 精度较低（如 ext4 的 1s）时也
+// 不漏判，这里同时比较文件大小，而不是只比较 ModTime.
+func (c *IniContainer) Watch(ctx context.Context) <-chan Event {
+	ch := make(chan Event)
+
+	ctx, cancel := context.WithCancel(ctx)
+	c.Lock()
+	if c.watch != nil {
+		c.watch.cancel()
+	}
+	c.watch = &watchState{cancel: cancel}
+	paths := append([]string(nil), c.sourcePaths...)
+	c.Unlock()
+
+	//在启动轮询 goroutine 之前就同步拿到基准状态，
+	//避免调用方在 Watch 返回后立刻改动文件、而轮询 goroutine 还没来得及跑第一次 statAll 的竞态.
+	states := statAll(paths)
+
+	go func() {
+		defer close(ch)
+		if len(paths) == 0 {
+			<-ctx.Done()
+			return
+		}
+		ticker := time.NewTicker(debounce)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				current := statAll(paths)
+				if !sameFileStates(states, current) {
+					states = current
+					c.reloadAndEmit(paths, ch, ctx)
+				}
+			}
+		}
+	}()
+
+	return ch
+}
+
+// OnChange 是 Watch 的便捷封装，每当文件被成功重新解析后回调 fn.
+func (c *IniContainer) OnChange(fn func(*IniContainer)) {
+	ch := c.Watch(context.Background())
+	go func() {
+		for evt := range ch {
+			if evt.Kind == EventReloaded {
+				fn(c)
+			}
+		}
+	}()
+}
+
+// StopWatch 停止 Watch/OnChange 启动的后台监听并释放资源.
+func (c *IniContainer) StopWatch() {
+	c.Lock()
+	defer c.Unlock()
+	if c.watch != nil {
+		c.watch.cancel()
+		c.watch = nil
+	}
+}
+
+//fileState 记录一次轮询时的文件状态，同时比较修改时间和大小，
+//避免在 mtime 精度较低的文件系统上，同一个 tick 内发生的两次写入被误判为没有变化.
+type fileState struct {
+	modTime time.Time
+	size    int64
+}
+
+func statAll(paths []string) map[string]fileState {
+	states := make(map[string]fileState, len(paths))
+	for _, p := range paths {
+		if info, err := os.Stat(p); err == nil {
+			states[p] = fileState{modTime: info.ModTime(), size: info.Size()}
+		}
+	}
+	return states
+}
+
+func sameFileStates(a, b map[string]fileState) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for p, s := range a {
+		bs, ok := b[p]
+		if !ok || !bs.modTime.Equal(s.modTime) || bs.size != s.size {
+			return false
+		}
+	}
+	return true
+}
+
+//reloadAndEmit 重新解析 paths[0]（原始入口文件），对比新旧值后发出 Event，
+//最后发出一个 EventReloaded.
+func (c *IniContainer) reloadAndEmit(paths []string, ch chan<- Event, ctx context.Context) {
+	next, err := parseFile(paths[0], DefaultSection)
+	if err != nil {
+		return
+	}
+
+	c.Lock()
+	old := c.values
+	c.values = next.values
+	c.sectionComment = next.sectionComment
+	c.sectionParent = next.sectionParent
+	c.endComment = next.endComment
+	c.Unlock()
+
+	for _, evt := range diffEntries(old, next.values) {
+		select {
+		case ch <- evt:
+		case <-ctx.Done():
+			return
+		}
+	}
+	select {
+	case ch <- Event{Kind: EventReloaded}:
+	case <-ctx.Done():
+	}
+}
+
+func diffEntries(old, next map[string]Entries) []Event {
+	var events []Event
+	for section, kv := range next {
+		oldKv := old[section]
+		for key, e := range kv {
+			oe, existed := oldKv[key]
+			if !existed {
+				events = append(events, Event{Section: section, Key: key, NewValue: e.value, Kind: EventChanged})
+				continue
+			}
+			if oe.value != e.value {
+				events = append(events, Event{Section: section, Key: key, OldValue: oe.value, NewValue: e.value, Kind: EventChanged})
+			}
+		}
+	}
+	for section, kv := range old {
+		nextKv := next[section]
+		for key, e := range kv {
+			if nextKv == nil {
+				events = append(events, Event{Section: section, Key: key, OldValue: e.value, Kind: EventChanged})
+				continue
+			}
+			if _, ok := nextKv[key]; !ok {
+				events = append(events, Event{Section: section, Key: key, OldValue: e.value, Kind: EventChanged})
+			}
+		}
+	}
+	return events
+}
@@ -0,0 +1,59 @@
+package goini
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestWatch_ReloadUpdatesSectionParent(t *testing.T) {
+	f, err := os.CreateTemp("", "goini_watch_*.ini")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := f.Name()
+	defer os.Remove(path)
+
+	if _, err := f.WriteString("[default]\nhost = example.com\n"); err != nil {
+		t.Fatal(err)
+	}
+	f.Close()
+
+	ini, err := LoadFromFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ini.Parent("staging"); ok {
+		t.Fatal("staging should have no parent before the file gains an inheriting section")
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ch := ini.Watch(ctx)
+
+	// 新内容比旧内容长，文件大小会变化，即使两次写入落在同一个 mtime 精度的 tick 内也能被轮询发现.
+	if err := os.WriteFile(path, []byte("[default]\nhost = example.com\n\n[staging : default]\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded := false
+	timeout := time.After(5 * time.Second)
+	for !reloaded {
+		select {
+		case evt, ok := <-ch:
+			if !ok {
+				t.Fatal("watch channel closed before EventReloaded was observed")
+			}
+			if evt.Kind == EventReloaded {
+				reloaded = true
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for EventReloaded")
+		}
+	}
+
+	if parent, ok := ini.Parent("staging"); !ok || parent != "default" {
+		t.Errorf("after reload, Parent(staging) = %q, %v; want default, true", parent, ok)
+	}
+}